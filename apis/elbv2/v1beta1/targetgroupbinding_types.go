@@ -121,6 +121,19 @@ type TargetGroupBindingNetworking struct {
 	Ingress []NetworkingIngressRule `json:"ingress,omitempty"`
 }
 
+// TargetGroupBindingHealthyThreshold defines the HealthyThresholdCount of TargetGroup during and after initial registration of targets.
+type TargetGroupBindingHealthyThreshold struct {
+	// initialCount is the HealthyThresholdCount applied while any target is under initial registration.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	InitialCount int64 `json:"initialCount"`
+
+	// steadyCount is the HealthyThresholdCount applied once all targets completed initial registration.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	SteadyCount int64 `json:"steadyCount"`
+}
+
 // TargetGroupBindingSpec defines the desired state of TargetGroupBinding
 type TargetGroupBindingSpec struct {
 	// targetGroupARN is the Amazon Resource Name (ARN) for the TargetGroup.
@@ -149,6 +162,10 @@ type TargetGroupBindingSpec struct {
 	// VpcID is the VPC of the TargetGroup. If unspecified, it will be automatically inferred.
 	// +optional
 	VpcID string `json:"vpcID,omitempty"`
+
+	// healthyThreshold configures different HealthyThresholdCount for TargetGroup during and after initial registration of targets.
+	// +optional
+	HealthyThreshold *TargetGroupBindingHealthyThreshold `json:"healthyThreshold,omitempty"`
 }
 
 // TargetGroupBindingStatus defines the observed state of TargetGroupBinding
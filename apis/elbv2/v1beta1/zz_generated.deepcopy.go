@@ -377,6 +377,21 @@ func (in *TargetGroupBinding) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupBindingHealthyThreshold) DeepCopyInto(out *TargetGroupBindingHealthyThreshold) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingHealthyThreshold.
+func (in *TargetGroupBindingHealthyThreshold) DeepCopy() *TargetGroupBindingHealthyThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupBindingHealthyThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetGroupBindingList) DeepCopyInto(out *TargetGroupBindingList) {
 	*out = *in
@@ -455,6 +470,11 @@ func (in *TargetGroupBindingSpec) DeepCopyInto(out *TargetGroupBindingSpec) {
 		*out = new(TargetGroupIPAddressType)
 		**out = **in
 	}
+	if in.HealthyThreshold != nil {
+		in, out := &in.HealthyThreshold, &out.HealthyThreshold
+		*out = new(TargetGroupBindingHealthyThreshold)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingSpec.
@@ -1444,6 +1444,32 @@ func Test_defaultEndpointResolver_ResolveNodePortEndpoints(t *testing.T) {
 			},
 		},
 	}
+	node6 := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-6",
+			Labels: map[string]string{
+				"labelA": "valueA",
+			},
+		},
+		Spec: corev1.NodeSpec{
+			ProviderID: "aws:///us-west-2b/i-abcdefg6",
+			Taints: []corev1.Taint{
+				{
+					Key:    spotInterruptionTaint,
+					Value:  "1700000000",
+					Effect: corev1.TaintEffectNoSchedule,
+				},
+			},
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{
+				{
+					Type:   corev1.NodeReady,
+					Status: corev1.ConditionTrue,
+				},
+			},
+		},
+	}
 	svc1 := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: testNS,
@@ -1638,6 +1664,25 @@ func Test_defaultEndpointResolver_ResolveNodePortEndpoints(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "don't choose ready node with spot interruption taint",
+			env: env{
+				nodes:    []*corev1.Node{node1, node6},
+				services: []*corev1.Service{svc1},
+			},
+			args: args{
+				svcKey: k8s.NamespacedName(svc1),
+				port:   intstr.FromString("http"),
+				opts:   []EndpointResolveOption{WithNodeSelector(labels.Set{"labelA": "valueA"}.AsSelectorPreValidated())},
+			},
+			want: []NodePortEndpoint{
+				{
+					InstanceID: "i-abcdefg1",
+					Port:       18080,
+					Node:       node1,
+				},
+			},
+		},
 		{
 			name: "no node will be chosen by default",
 			env: env{
@@ -13,7 +13,8 @@ const (
 	labelAlphaNodeRoleExcludeBalancer = "alpha.service-controller.kubernetes.io/exclude-balancer"
 	labelEKSComputeType               = "eks.amazonaws.com/compute-type"
 
-	toBeDeletedByCATaint = "ToBeDeletedByClusterAutoscaler"
+	toBeDeletedByCATaint  = "ToBeDeletedByClusterAutoscaler"
+	spotInterruptionTaint = "aws-node-termination-handler/spot-itn"
 )
 
 var (
@@ -62,9 +63,11 @@ func GetTrafficProxyNodeSelector(tgb *elbv2api.TargetGroupBinding) (labels.Selec
 // This should be checked in additional to the nodeSelector defined in TargetGroupBinding.
 func IsNodeSuitableAsTrafficProxy(node *corev1.Node) bool {
 	// ToBeDeletedByClusterAutoscaler taint is added by cluster autoscaler before removing node from cluster
-	// Marking the node as unsuitable for traffic once the taint is observed on the node
+	// spot-itn taint is added by aws-node-termination-handler once a spot interruption notice is received for the node
+	// Marking the node as unsuitable for traffic once the taint is observed on the node, so its targets get deregistered
+	// before the instance is terminated.
 	for _, taint := range node.Spec.Taints {
-		if taint.Key == toBeDeletedByCATaint {
+		if taint.Key == toBeDeletedByCATaint || taint.Key == spotInterruptionTaint {
 			return false
 		}
 	}
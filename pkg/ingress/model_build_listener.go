@@ -70,6 +70,16 @@ func (t *defaultModelBuildTask) buildListenerDefaultActions(ctx context.Context,
 		}
 	}
 	if len(ingsWithDefaultBackend) == 0 {
+		if t.defaultFixedResponseConfig != nil {
+			actionFixedResponse, err := t.buildFixedResponseAction(ctx, Action{
+				Type:                ActionTypeFixedResponse,
+				FixedResponseConfig: t.defaultFixedResponseConfig,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return []elbv2model.Action{actionFixedResponse}, nil
+		}
 		action404 := t.build404Action(ctx)
 		return []elbv2model.Action{action404}, nil
 	}
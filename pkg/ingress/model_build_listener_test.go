@@ -2,6 +2,7 @@ package ingress
 
 import (
 	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
 	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -103,3 +104,79 @@ func Test_computeIngressListenPortConfigByPort_MutualAuthentication(t *testing.T
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildListenerDefaultActions(t *testing.T) {
+	type fields struct {
+		defaultFixedResponseConfig *FixedResponseActionConfig
+	}
+	type args struct {
+		protocol elbv2.Protocol
+		ingList  []ClassifiedIngress
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   []elbv2.Action
+	}{
+		{
+			name:   "no default backend and no default fixed-response",
+			fields: fields{},
+			args: args{
+				protocol: elbv2.ProtocolHTTP,
+				ingList: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-1"}},
+					},
+				},
+			},
+			want: []elbv2.Action{
+				{
+					Type: elbv2.ActionTypeFixedResponse,
+					FixedResponseConfig: &elbv2.FixedResponseActionConfig{
+						ContentType: awssdk.String("text/plain"),
+						StatusCode:  "404",
+					},
+				},
+			},
+		},
+		{
+			name: "no default backend with default fixed-response",
+			fields: fields{
+				defaultFixedResponseConfig: &FixedResponseActionConfig{
+					ContentType: awssdk.String("application/json"),
+					MessageBody: awssdk.String(`{"error":"not found"}`),
+					StatusCode:  "404",
+				},
+			},
+			args: args{
+				protocol: elbv2.ProtocolHTTPS,
+				ingList: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-1"}},
+					},
+				},
+			},
+			want: []elbv2.Action{
+				{
+					Type: elbv2.ActionTypeFixedResponse,
+					FixedResponseConfig: &elbv2.FixedResponseActionConfig{
+						ContentType: awssdk.String("application/json"),
+						MessageBody: awssdk.String(`{"error":"not found"}`),
+						StatusCode:  "404",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				defaultFixedResponseConfig: tt.fields.defaultFixedResponseConfig,
+			}
+			got, err := task.buildListenerDefaultActions(context.Background(), tt.args.protocol, tt.args.ingList)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -22,6 +22,9 @@ import (
 
 const (
 	healthCheckPortTrafficPort = "traffic-port"
+
+	// tgAttrsConnectionTermination is only supported by NLB target groups.
+	tgAttrsConnectionTermination = "deregistration_delay.connection_termination.enabled"
 )
 
 func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context,
@@ -436,6 +439,9 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, sv
 	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTargetGroupAttributes, &rawAttributes, svcAndIngAnnotations); err != nil {
 		return nil, err
 	}
+	if _, ok := rawAttributes[tgAttrsConnectionTermination]; ok {
+		return nil, errors.Errorf("target group attribute %v is only supported for Network Load Balancers", tgAttrsConnectionTermination)
+	}
 	attributes := make([]elbv2model.TargetGroupAttribute, 0, len(rawAttributes))
 	for attrKey, attrValue := range rawAttributes {
 		attributes = append(attributes, elbv2model.TargetGroupAttribute{
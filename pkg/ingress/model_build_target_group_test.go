@@ -600,6 +600,63 @@ func Test_defaultModelBuildTask_buildTargetGroupHealthCheckMatcher(t *testing.T)
 	}
 }
 
+func Test_defaultModelBuildTask_buildTargetGroupAttributes(t *testing.T) {
+	type args struct {
+		svcAndIngAnnotations map[string]string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []elbv2model.TargetGroupAttribute
+		wantErr error
+	}{
+		{
+			name: "without annotation configured",
+			args: args{
+				svcAndIngAnnotations: nil,
+			},
+			want: []elbv2model.TargetGroupAttribute{},
+		},
+		{
+			name: "with annotation configured",
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/target-group-attributes": "deregistration_delay.timeout_seconds=30",
+				},
+			},
+			want: []elbv2model.TargetGroupAttribute{
+				{
+					Key:   "deregistration_delay.timeout_seconds",
+					Value: "30",
+				},
+			},
+		},
+		{
+			name: "with NLB only connection termination attribute",
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/target-group-attributes": "deregistration_delay.connection_termination.enabled=true",
+				},
+			},
+			wantErr: errors.New("target group attribute deregistration_delay.connection_termination.enabled is only supported for Network Load Balancers"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildTargetGroupAttributes(context.Background(), tt.args.svcAndIngAnnotations)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func Test_defaultModelBuildTask_buildTargetGroupBindingNodeSelector(t *testing.T) {
 	type args struct {
 		ing        ClassifiedIngress
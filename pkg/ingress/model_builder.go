@@ -187,14 +187,15 @@ type defaultModelBuildTask struct {
 	featureGates           config.FeatureGates
 	logger                 logr.Logger
 
-	ingGroup                 Group
-	sslRedirectConfig        *SSLRedirectConfig
-	stack                    core.Stack
-	backendSGIDToken         core.StringToken
-	backendSGAllocated       bool
-	enableBackendSG          bool
-	disableRestrictedSGRules bool
-	enableIPTargetType       bool
+	ingGroup                   Group
+	sslRedirectConfig          *SSLRedirectConfig
+	defaultFixedResponseConfig *FixedResponseActionConfig
+	stack                      core.Stack
+	backendSGIDToken           core.StringToken
+	backendSGAllocated         bool
+	enableBackendSG            bool
+	disableRestrictedSGRules   bool
+	enableIPTargetType         bool
 
 	defaultTags                               map[string]string
 	externalManagedTags                       sets.String
@@ -270,6 +271,7 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	t.defaultFixedResponseConfig, err = t.buildDefaultFixedResponseConfig(ctx)
 	if err != nil {
 		return err
 	}
@@ -425,6 +427,34 @@ func (t *defaultModelBuildTask) buildSSLRedirectConfig(ctx context.Context, list
 	}, nil
 }
 
+// buildDefaultFixedResponseConfig computes the fixed-response config used as listener default action for the IngressGroup
+// when no Ingress defines a default backend. Returns nil if there is no default fixed-response configured.
+func (t *defaultModelBuildTask) buildDefaultFixedResponseConfig(_ context.Context) (*FixedResponseActionConfig, error) {
+	var mergedCfgProvider *types.NamespacedName
+	var mergedCfg *FixedResponseActionConfig
+	for _, member := range t.ingGroup.Members {
+		ingKey := k8s.NamespacedName(member.Ing)
+		rawCfg := FixedResponseActionConfig{}
+		exists, err := t.annotationParser.ParseJSONAnnotation(annotations.IngressSuffixDefaultFixedResponse, &rawCfg, member.Ing.Annotations)
+		if err != nil {
+			return nil, errors.Wrapf(err, "ingress: %v", ingKey)
+		}
+		if !exists {
+			continue
+		}
+		if err := rawCfg.validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid default fixed-response config on ingress: %v", ingKey)
+		}
+		if mergedCfgProvider == nil {
+			mergedCfgProvider = &ingKey
+			mergedCfg = &rawCfg
+		} else if !reflect.DeepEqual(*mergedCfg, rawCfg) {
+			return nil, errors.Errorf("conflicting default fixed-response, %v | %v", *mergedCfgProvider, ingKey)
+		}
+	}
+	return mergedCfg, nil
+}
+
 func (t *defaultModelBuildTask) getDeletionProtectionViaAnnotation(ing *networking.Ingress) (bool, error) {
 	var lbAttributes map[string]string
 	_, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixLoadBalancerAttributes, &lbAttributes, ing.Annotations)
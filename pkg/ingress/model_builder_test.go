@@ -4376,3 +4376,160 @@ func Test_defaultModelBuildTask_buildSSLRedirectConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildDefaultFixedResponseConfig(t *testing.T) {
+	type fields struct {
+		ingGroup Group
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    *FixedResponseActionConfig
+		wantErr error
+	}{
+		{
+			name: "single Ingress without default-fixed-response annotation",
+			fields: fields{
+				ingGroup: Group{
+					ID: GroupID{Namespace: "ns-1", Name: "ing-1"},
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+								Namespace: "ns-1",
+								Name:      "ing-1",
+							}},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "single Ingress with default-fixed-response annotation",
+			fields: fields{
+				ingGroup: Group{
+					ID: GroupID{Namespace: "ns-1", Name: "ing-1"},
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+								Namespace: "ns-1",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/default-fixed-response": `{"contentType":"application/json","messageBody":"{\"error\":\"not found\"}","statusCode":"404"}`,
+								},
+							}},
+						},
+					},
+				},
+			},
+			want: &FixedResponseActionConfig{
+				ContentType: awssdk.String("application/json"),
+				MessageBody: awssdk.String(`{"error":"not found"}`),
+				StatusCode:  "404",
+			},
+		},
+		{
+			name: "multiple Ingress with same default-fixed-response annotation",
+			fields: fields{
+				ingGroup: Group{
+					ID: GroupID{Name: "awesome-group"},
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+								Namespace: "ns-1",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/default-fixed-response": `{"contentType":"text/plain","statusCode":"404"}`,
+								},
+							}},
+						},
+						{
+							Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+								Namespace: "ns-2",
+								Name:      "ing-2",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/default-fixed-response": `{"contentType":"text/plain","statusCode":"404"}`,
+								},
+							}},
+						},
+						{
+							Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+								Namespace: "ns-3",
+								Name:      "ing-3",
+							}},
+						},
+					},
+				},
+			},
+			want: &FixedResponseActionConfig{
+				ContentType: awssdk.String("text/plain"),
+				StatusCode:  "404",
+			},
+		},
+		{
+			name: "multiple Ingress with conflicting default-fixed-response annotation",
+			fields: fields{
+				ingGroup: Group{
+					ID: GroupID{Name: "awesome-group"},
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+								Namespace: "ns-1",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/default-fixed-response": `{"contentType":"text/plain","statusCode":"404"}`,
+								},
+							}},
+						},
+						{
+							Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+								Namespace: "ns-2",
+								Name:      "ing-2",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/default-fixed-response": `{"contentType":"text/plain","statusCode":"503"}`,
+								},
+							}},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting default fixed-response, ns-1/ing-1 | ns-2/ing-2"),
+		},
+		{
+			name: "single Ingress with default-fixed-response annotation missing statusCode",
+			fields: fields{
+				ingGroup: Group{
+					ID: GroupID{Namespace: "ns-1", Name: "ing-1"},
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+								Namespace: "ns-1",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/default-fixed-response": `{"contentType":"text/plain"}`,
+								},
+							}},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("invalid default fixed-response config on ingress: ns-1/ing-1: statusCode is required"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			task := &defaultModelBuildTask{
+				annotationParser: annotationParser,
+				ingGroup:         tt.fields.ingGroup,
+			}
+			got, err := task.buildDefaultFixedResponseConfig(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
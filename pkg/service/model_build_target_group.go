@@ -27,6 +27,7 @@ import (
 const (
 	tgAttrsProxyProtocolV2Enabled  = "proxy_protocol_v2.enabled"
 	tgAttrsPreserveClientIPEnabled = "preserve_client_ip.enabled"
+	tgAttrsConnectionTermination   = "deregistration_delay.connection_termination.enabled"
 	healthCheckPortTrafficPort     = "traffic-port"
 )
 
@@ -228,6 +229,12 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context) ([
 			return nil, errors.Wrapf(err, "failed to parse attribute %v=%v", tgAttrsPreserveClientIPEnabled, rawPreserveIPEnabled)
 		}
 	}
+	if rawConnectionTermination, ok := rawAttributes[tgAttrsConnectionTermination]; ok {
+		_, err := strconv.ParseBool(rawConnectionTermination)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse attribute %v=%v", tgAttrsConnectionTermination, rawConnectionTermination)
+		}
+	}
 	attributes := make([]elbv2model.TargetGroupAttribute, 0, len(rawAttributes))
 	for attrKey, attrValue := range rawAttributes {
 		attributes = append(attributes, elbv2model.TargetGroupAttribute{
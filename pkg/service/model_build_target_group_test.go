@@ -129,6 +129,37 @@ func Test_defaultModelBuilderTask_targetGroupAttrs(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			testName: "connection termination enabled",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": tgAttrsConnectionTermination + "=true",
+					},
+				},
+			},
+			wantValue: []elbv2.TargetGroupAttribute{
+				{
+					Key:   tgAttrsConnectionTermination,
+					Value: "true",
+				},
+				{
+					Key:   tgAttrsProxyProtocolV2Enabled,
+					Value: "false",
+				},
+			},
+		},
+		{
+			testName: "connection termination attribute parse error",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": tgAttrsConnectionTermination + "=enabled",
+					},
+				},
+			},
+			wantError: true,
+		},
 		{
 			testName: "IP enabled attribute parse error",
 			svc: &corev1.Service{
@@ -0,0 +1,84 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"sync"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+// HealthyThresholdManager manages the HealthyThresholdCount of TargetGroup during and after initial registration of targets.
+type HealthyThresholdManager interface {
+	// Reconcile the HealthyThresholdCount of TargetGroup based on whether any target is under initial registration.
+	Reconcile(ctx context.Context, tgb *elbv2api.TargetGroupBinding, initialRegistration bool) error
+
+	// Cleanup the state tracked for TargetGroup.
+	Cleanup(ctx context.Context, tgb *elbv2api.TargetGroupBinding)
+}
+
+// NewDefaultHealthyThresholdManager constructs new defaultHealthyThresholdManager.
+func NewDefaultHealthyThresholdManager(elbv2Client services.ELBV2, logger logr.Logger) *defaultHealthyThresholdManager {
+	return &defaultHealthyThresholdManager{
+		elbv2Client:      elbv2Client,
+		thresholdByTGARN: make(map[string]int64),
+		logger:           logger,
+	}
+}
+
+var _ HealthyThresholdManager = &defaultHealthyThresholdManager{}
+
+// default implementation for HealthyThresholdManager.
+type defaultHealthyThresholdManager struct {
+	elbv2Client services.ELBV2
+
+	// the HealthyThresholdCount last applied by us for each TargetGroup.
+	thresholdByTGARN map[string]int64
+	// thresholdByTGARNMutex protects thresholdByTGARN
+	thresholdByTGARNMutex sync.Mutex
+
+	logger logr.Logger
+}
+
+func (m *defaultHealthyThresholdManager) Reconcile(ctx context.Context, tgb *elbv2api.TargetGroupBinding, initialRegistration bool) error {
+	tgARN := tgb.Spec.TargetGroupARN
+	if tgb.Spec.HealthyThreshold == nil {
+		m.Cleanup(ctx, tgb)
+		return nil
+	}
+	desiredThreshold := tgb.Spec.HealthyThreshold.SteadyCount
+	if initialRegistration {
+		desiredThreshold = tgb.Spec.HealthyThreshold.InitialCount
+	}
+
+	m.thresholdByTGARNMutex.Lock()
+	defer m.thresholdByTGARNMutex.Unlock()
+	if appliedThreshold, exists := m.thresholdByTGARN[tgARN]; exists && appliedThreshold == desiredThreshold {
+		return nil
+	}
+
+	req := &elbv2sdk.ModifyTargetGroupInput{
+		TargetGroupArn:        awssdk.String(tgARN),
+		HealthyThresholdCount: awssdk.Int64(desiredThreshold),
+	}
+	m.logger.Info("modifying targetGroup healthyThresholdCount",
+		"arn", tgARN,
+		"initialRegistration", initialRegistration,
+		"healthyThresholdCount", desiredThreshold)
+	if _, err := m.elbv2Client.ModifyTargetGroupWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified targetGroup healthyThresholdCount",
+		"arn", tgARN)
+	m.thresholdByTGARN[tgARN] = desiredThreshold
+	return nil
+}
+
+func (m *defaultHealthyThresholdManager) Cleanup(_ context.Context, tgb *elbv2api.TargetGroupBinding) {
+	m.thresholdByTGARNMutex.Lock()
+	defer m.thresholdByTGARNMutex.Unlock()
+	delete(m.thresholdByTGARN, tgb.Spec.TargetGroupARN)
+}
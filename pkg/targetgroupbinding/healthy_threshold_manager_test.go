@@ -0,0 +1,186 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultHealthyThresholdManager_Reconcile(t *testing.T) {
+	type modifyTargetGroupWithContextCall struct {
+		req  *elbv2sdk.ModifyTargetGroupInput
+		resp *elbv2sdk.ModifyTargetGroupOutput
+		err  error
+	}
+	type fields struct {
+		thresholdByTGARN                  map[string]int64
+		modifyTargetGroupWithContextCalls []modifyTargetGroupWithContextCall
+	}
+	type args struct {
+		tgb                 *elbv2api.TargetGroupBinding
+		initialRegistration bool
+	}
+	healthyThreshold := &elbv2api.TargetGroupBindingHealthyThreshold{
+		InitialCount: 5,
+		SteadyCount:  2,
+	}
+	tests := []struct {
+		name                 string
+		fields               fields
+		args                 args
+		wantThresholdByTGARN map[string]int64
+		wantErr              error
+	}{
+		{
+			name: "healthyThreshold not configured",
+			fields: fields{
+				thresholdByTGARN: map[string]int64{
+					"my-tg": 5,
+				},
+			},
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN: "my-tg",
+					},
+				},
+				initialRegistration: true,
+			},
+			wantThresholdByTGARN: map[string]int64{},
+		},
+		{
+			name: "raise threshold during initial registration",
+			fields: fields{
+				thresholdByTGARN: map[string]int64{},
+				modifyTargetGroupWithContextCalls: []modifyTargetGroupWithContextCall{
+					{
+						req: &elbv2sdk.ModifyTargetGroupInput{
+							TargetGroupArn:        awssdk.String("my-tg"),
+							HealthyThresholdCount: awssdk.Int64(5),
+						},
+						resp: &elbv2sdk.ModifyTargetGroupOutput{},
+					},
+				},
+			},
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN:   "my-tg",
+						HealthyThreshold: healthyThreshold,
+					},
+				},
+				initialRegistration: true,
+			},
+			wantThresholdByTGARN: map[string]int64{
+				"my-tg": 5,
+			},
+		},
+		{
+			name: "lower threshold after initial registration",
+			fields: fields{
+				thresholdByTGARN: map[string]int64{
+					"my-tg": 5,
+				},
+				modifyTargetGroupWithContextCalls: []modifyTargetGroupWithContextCall{
+					{
+						req: &elbv2sdk.ModifyTargetGroupInput{
+							TargetGroupArn:        awssdk.String("my-tg"),
+							HealthyThresholdCount: awssdk.Int64(2),
+						},
+						resp: &elbv2sdk.ModifyTargetGroupOutput{},
+					},
+				},
+			},
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN:   "my-tg",
+						HealthyThreshold: healthyThreshold,
+					},
+				},
+				initialRegistration: false,
+			},
+			wantThresholdByTGARN: map[string]int64{
+				"my-tg": 2,
+			},
+		},
+		{
+			name: "threshold already applied",
+			fields: fields{
+				thresholdByTGARN: map[string]int64{
+					"my-tg": 2,
+				},
+			},
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN:   "my-tg",
+						HealthyThreshold: healthyThreshold,
+					},
+				},
+				initialRegistration: false,
+			},
+			wantThresholdByTGARN: map[string]int64{
+				"my-tg": 2,
+			},
+		},
+		{
+			name: "modify targetGroup failed",
+			fields: fields{
+				thresholdByTGARN: map[string]int64{
+					"my-tg": 2,
+				},
+				modifyTargetGroupWithContextCalls: []modifyTargetGroupWithContextCall{
+					{
+						req: &elbv2sdk.ModifyTargetGroupInput{
+							TargetGroupArn:        awssdk.String("my-tg"),
+							HealthyThresholdCount: awssdk.Int64(5),
+						},
+						err: errors.New("some error"),
+					},
+				},
+			},
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetGroupARN:   "my-tg",
+						HealthyThreshold: healthyThreshold,
+					},
+				},
+				initialRegistration: true,
+			},
+			wantErr: errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.fields.modifyTargetGroupWithContextCalls {
+				elbv2Client.EXPECT().ModifyTargetGroupWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+
+			m := &defaultHealthyThresholdManager{
+				elbv2Client:      elbv2Client,
+				thresholdByTGARN: tt.fields.thresholdByTGARN,
+				logger:           log.Log,
+			}
+			err := m.Reconcile(context.Background(), tt.args.tgb, tt.args.initialRegistration)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantThresholdByTGARN, m.thresholdByTGARN)
+			}
+		})
+	}
+}
@@ -42,6 +42,7 @@ func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELB
 	endpointSGTags map[string]string,
 	eventRecorder record.EventRecorder, logger logr.Logger) *defaultResourceManager {
 	targetsManager := NewCachedTargetsManager(elbv2Client, logger)
+	healthyThresholdManager := NewDefaultHealthyThresholdManager(elbv2Client, logger)
 	endpointResolver := backend.NewDefaultEndpointResolver(k8sClient, podInfoRepo, failOpenEnabled, endpointSliceEnabled, logger)
 
 	nodeInfoProvider := networking.NewDefaultNodeInfoProvider(ec2Client, logger)
@@ -50,15 +51,16 @@ func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELB
 
 	networkingManager := NewDefaultNetworkingManager(k8sClient, podENIResolver, nodeENIResolver, sgManager, sgReconciler, vpcID, clusterName, endpointSGTags, logger, disabledRestrictedSGRulesFlag)
 	return &defaultResourceManager{
-		k8sClient:         k8sClient,
-		targetsManager:    targetsManager,
-		endpointResolver:  endpointResolver,
-		networkingManager: networkingManager,
-		eventRecorder:     eventRecorder,
-		logger:            logger,
-		vpcID:             vpcID,
-		vpcInfoProvider:   vpcInfoProvider,
-		podInfoRepo:       podInfoRepo,
+		k8sClient:               k8sClient,
+		targetsManager:          targetsManager,
+		healthyThresholdManager: healthyThresholdManager,
+		endpointResolver:        endpointResolver,
+		networkingManager:       networkingManager,
+		eventRecorder:           eventRecorder,
+		logger:                  logger,
+		vpcID:                   vpcID,
+		vpcInfoProvider:         vpcInfoProvider,
+		podInfoRepo:             podInfoRepo,
 
 		targetHealthRequeueDuration: defaultTargetHealthRequeueDuration,
 	}
@@ -68,15 +70,16 @@ var _ ResourceManager = &defaultResourceManager{}
 
 // default implementation for ResourceManager.
 type defaultResourceManager struct {
-	k8sClient         client.Client
-	targetsManager    TargetsManager
-	endpointResolver  backend.EndpointResolver
-	networkingManager NetworkingManager
-	eventRecorder     record.EventRecorder
-	logger            logr.Logger
-	vpcInfoProvider   networking.VPCInfoProvider
-	podInfoRepo       k8s.PodInfoRepo
-	vpcID             string
+	k8sClient               client.Client
+	targetsManager          TargetsManager
+	healthyThresholdManager HealthyThresholdManager
+	endpointResolver        backend.EndpointResolver
+	networkingManager       NetworkingManager
+	eventRecorder           record.EventRecorder
+	logger                  logr.Logger
+	vpcInfoProvider         networking.VPCInfoProvider
+	podInfoRepo             k8s.PodInfoRepo
+	vpcID                   string
 
 	targetHealthRequeueDuration time.Duration
 }
@@ -101,6 +104,7 @@ func (m *defaultResourceManager) Cleanup(ctx context.Context, tgb *elbv2api.Targ
 	if err := m.updatePodAsHealthyForDeletedTGB(ctx, tgb); err != nil {
 		return err
 	}
+	m.healthyThresholdManager.Cleanup(ctx, tgb)
 	return nil
 }
 
@@ -150,6 +154,10 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 			return err
 		}
 	}
+	initialRegistration := len(unmatchedEndpoints) != 0 || containsTargetsInInitialState(matchedEndpointAndTargets)
+	if err := m.healthyThresholdManager.Reconcile(ctx, tgb, initialRegistration); err != nil {
+		return err
+	}
 
 	anyPodNeedFurtherProbe, err := m.updateTargetHealthPodCondition(ctx, targetHealthCondType, matchedEndpointAndTargets, unmatchedEndpoints)
 	if err != nil {
@@ -169,6 +177,10 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 
 	_ = drainingTargets
 
+	if initialRegistration && tgb.Spec.HealthyThreshold != nil {
+		return runtime.NewRequeueNeededAfter("monitor initial registration", m.targetHealthRequeueDuration)
+	}
+
 	if needNetworkingRequeue {
 		return runtime.NewRequeueNeeded("networking reconciliation")
 	}
@@ -197,7 +209,7 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 		return err
 	}
 	notDrainingTargets, drainingTargets := partitionTargetsByDrainingStatus(targets)
-	_, unmatchedEndpoints, unmatchedTargets := matchNodePortEndpointWithTargets(endpoints, notDrainingTargets)
+	matchedEndpointAndTargets, unmatchedEndpoints, unmatchedTargets := matchNodePortEndpointWithTargets(endpoints, notDrainingTargets)
 
 	if err := m.networkingManager.ReconcileForNodePortEndpoints(ctx, tgb, endpoints); err != nil {
 		return err
@@ -212,7 +224,15 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 			return err
 		}
 	}
+	initialRegistration := len(unmatchedEndpoints) != 0 || containsNodePortTargetsInInitialState(matchedEndpointAndTargets)
+	if err := m.healthyThresholdManager.Reconcile(ctx, tgb, initialRegistration); err != nil {
+		return err
+	}
 	_ = drainingTargets
+
+	if initialRegistration && tgb.Spec.HealthyThreshold != nil {
+		return runtime.NewRequeueNeededAfter("monitor initial registration", m.targetHealthRequeueDuration)
+	}
 	return nil
 }
 
@@ -459,6 +479,15 @@ func containsTargetsInInitialState(matchedEndpointAndTargets []podEndpointAndTar
 	return false
 }
 
+func containsNodePortTargetsInInitialState(matchedEndpointAndTargets []nodePortEndpointAndTargetPair) bool {
+	for _, endpointAndTarget := range matchedEndpointAndTargets {
+		if endpointAndTarget.target.IsInitial() {
+			return true
+		}
+	}
+	return false
+}
+
 func matchPodEndpointWithTargets(endpoints []backend.PodEndpoint, targets []TargetInfo) ([]podEndpointAndTargetPair, []backend.PodEndpoint, []TargetInfo) {
 	var matchedEndpointAndTargets []podEndpointAndTargetPair
 	var unmatchedEndpoints []backend.PodEndpoint
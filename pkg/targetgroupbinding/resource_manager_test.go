@@ -463,3 +463,53 @@ func Test_containsTargetsInInitialState(t *testing.T) {
 		})
 	}
 }
+
+func Test_containsNodePortTargetsInInitialState(t *testing.T) {
+	type args struct {
+		matchedEndpointAndTargets []nodePortEndpointAndTargetPair
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "contains initial targets",
+			args: args{
+				matchedEndpointAndTargets: []nodePortEndpointAndTargetPair{
+					{
+						target: TargetInfo{
+							TargetHealth: &elbv2sdk.TargetHealth{
+								State:       awssdk.String(elbv2sdk.TargetHealthStateEnumInitial),
+								Reason:      awssdk.String(elbv2sdk.TargetHealthReasonEnumElbRegistrationInProgress),
+								Description: awssdk.String("Target registration is in progress"),
+							},
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "contains no initial targets",
+			args: args{
+				matchedEndpointAndTargets: []nodePortEndpointAndTargetPair{
+					{
+						target: TargetInfo{
+							TargetHealth: &elbv2sdk.TargetHealth{
+								State: awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy),
+							},
+						},
+					},
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := containsNodePortTargetsInInitialState(tt.args.matchedEndpointAndTargets)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}